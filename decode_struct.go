@@ -5,6 +5,8 @@ import (
 	"math"
 	"sort"
 	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
 	"unsafe"
 )
 
@@ -22,10 +24,14 @@ type structDecoder struct {
 	structName       string
 	fieldName        string
 	isTriedOptimize  bool
+	caseSensitive    bool
+	hasCatchAll      bool
+	catchAllOffset   uintptr
 	keyBitmapInt8    [][256]int8
 	keyBitmapInt16   [][256]int16
 	sortedFieldSets  []*structFieldSet
-	keyDecoder       func(*structDecoder, []byte, int64) (int64, *structFieldSet, error)
+	groups           []*bitmapGroup
+	keyDecoder       func(*structDecoder, []byte, int64) (int64, *structFieldSet, string, error)
 	keyStreamDecoder func(*structDecoder, *stream) (*structFieldSet, string, error)
 }
 
@@ -49,40 +55,176 @@ func init() {
 	}
 }
 
-func newStructDecoder(structName, fieldName string, fieldMap map[string]*structFieldSet) *structDecoder {
+func newStructDecoder(structName, fieldName string, caseSensitive bool, fieldMap map[string]*structFieldSet, catchAllOffset uintptr, hasCatchAll bool) *structDecoder {
 	return &structDecoder{
 		fieldMap:         fieldMap,
 		stringDecoder:    newStringDecoder(structName, fieldName),
 		structName:       structName,
 		fieldName:        fieldName,
+		caseSensitive:    caseSensitive,
+		catchAllOffset:   catchAllOffset,
+		hasCatchAll:      hasCatchAll,
 		keyDecoder:       decodeKey,
 		keyStreamDecoder: decodeKeyStream,
 	}
 }
 
+// assignCatchAllField stores an unmatched object key's raw JSON value into
+// the struct's designated catch-all field (json:",inline"/json:",unknown"),
+// cloning the bytes since buf/s.buf may be reused or grown by the caller.
+func assignCatchAllField(p unsafe.Pointer, offset uintptr, key string, raw []byte) {
+	m := (*map[string]RawMessage)(unsafe.Pointer(uintptr(p) + offset))
+	if *m == nil {
+		*m = make(map[string]RawMessage)
+	}
+	cloned := make(RawMessage, len(raw))
+	copy(cloned, raw)
+	// key is a zero-copy view into buf (see bufToString), which the caller
+	// may reuse or grow after this returns; the map entry outlives that
+	// call, so it needs its own backing storage just like the value does.
+	(*m)[strings.Clone(key)] = cloned
+}
+
+// bufToString views buf as a string without copying, the same trick decodeKey
+// already relies on for its stringDecoder output.
+func bufToString(buf []byte) string {
+	return *(*string)(unsafe.Pointer(&buf))
+}
+
+// unescapeKey decodes a raw key slice captured between quotes by the bitmap
+// fast paths (decodeKeyByBitmapInt8/Int16/Groups and their Stream
+// counterparts). Those paths only watch for '\\' to avoid mistaking an
+// escaped quote for the terminating one; unlike decodeKey's stringDecoder
+// path, they never turn the escape sequences themselves into the bytes they
+// represent. Most keys are struct field names with no escapes at all, so the
+// common case stays a zero-copy view; only a key containing '\\' pays for an
+// actual decode.
+func unescapeKey(raw []byte) string {
+	i := 0
+	for i < len(raw) {
+		if raw[i] == '\\' {
+			break
+		}
+		i++
+	}
+	if i == len(raw) {
+		return bufToString(raw)
+	}
+
+	buf := make([]byte, 0, len(raw))
+	buf = append(buf, raw[:i]...)
+	for i < len(raw) {
+		c := raw[i]
+		if c != '\\' {
+			buf = append(buf, c)
+			i++
+			continue
+		}
+		i++
+		if i >= len(raw) {
+			break
+		}
+		switch raw[i] {
+		case '"':
+			buf = append(buf, '"')
+		case '\\':
+			buf = append(buf, '\\')
+		case '/':
+			buf = append(buf, '/')
+		case 'b':
+			buf = append(buf, '\b')
+		case 'f':
+			buf = append(buf, '\f')
+		case 'n':
+			buf = append(buf, '\n')
+		case 'r':
+			buf = append(buf, '\r')
+		case 't':
+			buf = append(buf, '\t')
+		case 'u':
+			r := rune(decodeHex4(raw[i+1:]))
+			i += 4
+			if utf16.IsSurrogate(r) {
+				if i+6 <= len(raw) && raw[i+1] == '\\' && raw[i+2] == 'u' {
+					r2 := rune(decodeHex4(raw[i+3:]))
+					if dec := utf16.DecodeRune(r, r2); dec != utf8.RuneError {
+						r = dec
+						i += 6
+					}
+				}
+			}
+			var tmp [utf8.UTFMax]byte
+			n := utf8.EncodeRune(tmp[:], r)
+			buf = append(buf, tmp[:n]...)
+		default:
+			buf = append(buf, raw[i])
+		}
+		i++
+	}
+	return bufToString(buf)
+}
+
+// decodeHex4 reads the 4 hex digits following a \u escape. b is expected to
+// have at least 4 bytes; a malformed or truncated escape decodes as 0 rather
+// than erroring, matching the bitmap fast paths' own habit of not
+// revalidating what skipValue already walked over.
+func decodeHex4(b []byte) uint16 {
+	if len(b) < 4 {
+		return 0
+	}
+	var v uint16
+	for i := 0; i < 4 && i < len(b); i++ {
+		v <<= 4
+		c := b[i]
+		switch {
+		case '0' <= c && c <= '9':
+			v |= uint16(c - '0')
+		case 'a' <= c && c <= 'f':
+			v |= uint16(c-'a') + 10
+		case 'A' <= c && c <= 'F':
+			v |= uint16(c-'A') + 10
+		default:
+			return 0
+		}
+	}
+	return v
+}
+
 const (
-	allowOptimizeMaxKeyLen   = 64
-	allowOptimizeMaxFieldLen = 16
+	allowOptimizeMaxKeyLen        = 64
+	allowOptimizeMaxFieldLen      = 16
+	allowOptimizeMaxGroupFieldLen = 128
 )
 
+// bitmapGroup is one allowOptimizeMaxFieldLen-sized partition of a wide
+// struct's field set. Fields beyond what a single keyBitmapInt16 table can
+// address are split across groups, each carrying its own bitmap and
+// sortedFieldSets so the de Bruijn hash in bitHashTable stays valid within
+// the group.
+type bitmapGroup struct {
+	keyBitmap       [][256]int16
+	sortedFieldSets []*structFieldSet
+}
+
 func (d *structDecoder) tryOptimize() {
 	if d.isTriedOptimize {
 		return
 	}
 	fieldMap := map[string]*structFieldSet{}
-	conflicted := map[string]struct{}{}
 	for k, v := range d.fieldMap {
-		key := strings.ToLower(k)
-		if key != k {
-			// already exists same key (e.g. Hello and HELLO has same lower case key
-			if _, exists := conflicted[key]; exists {
-				d.isTriedOptimize = true
-				return
-			}
-			conflicted[key] = struct{}{}
+		key := k
+		if !d.caseSensitive {
+			key = strings.ToLower(k)
 		}
 		if field, exists := fieldMap[key]; exists {
-			if field != v {
+			// Two distinct raw keys (e.g. "Hello" and "HELLO", or two
+			// case-varying aliases of the same field) can fold to the same
+			// lower-cased key. That's fine as long as they resolve to the
+			// same field (aliases get their own *structFieldSet per alias
+			// name, so compare what they decode into rather than pointer
+			// identity); anything else defeats the bitmap fast path since
+			// it can no longer tell the fields apart.
+			if field.dec != v.dec || field.offset != v.offset {
 				d.isTriedOptimize = true
 				return
 			}
@@ -90,7 +232,7 @@ func (d *structDecoder) tryOptimize() {
 		fieldMap[key] = v
 	}
 
-	if len(fieldMap) > allowOptimizeMaxFieldLen {
+	if len(fieldMap) > allowOptimizeMaxGroupFieldLen {
 		d.isTriedOptimize = true
 		return
 	}
@@ -109,34 +251,98 @@ func (d *structDecoder) tryOptimize() {
 		sortedKeys = append(sortedKeys, key)
 	}
 	sort.Strings(sortedKeys)
-	if len(sortedKeys) <= 8 {
-		keyBitmap := make([][256]int8, maxKeyLen)
-		for i, key := range sortedKeys {
-			for j := 0; j < len(key); j++ {
-				c := key[j]
-				keyBitmap[j][c] |= (1 << uint(i))
+
+	if len(sortedKeys) <= allowOptimizeMaxFieldLen {
+		if len(sortedKeys) <= 8 {
+			keyBitmap := make([][256]int8, maxKeyLen)
+			for i, key := range sortedKeys {
+				for j := 0; j < len(key); j++ {
+					c := key[j]
+					keyBitmap[j][c] |= (1 << uint(i))
+				}
+				d.sortedFieldSets = append(d.sortedFieldSets, fieldMap[key])
+			}
+			d.keyBitmapInt8 = keyBitmap
+			d.keyDecoder = decodeKeyByBitmapInt8
+			d.keyStreamDecoder = decodeKeyByBitmapInt8Stream
+		} else {
+			keyBitmap := make([][256]int16, maxKeyLen)
+			for i, key := range sortedKeys {
+				for j := 0; j < len(key); j++ {
+					c := key[j]
+					keyBitmap[j][c] |= (1 << uint(i))
+				}
+				d.sortedFieldSets = append(d.sortedFieldSets, fieldMap[key])
+			}
+			d.keyBitmapInt16 = keyBitmap
+			d.keyDecoder = decodeKeyByBitmapInt16
+			d.keyStreamDecoder = decodeKeyByBitmapInt16Stream
+		}
+		return
+	}
+
+	// Too many fields for a single bitmap table: partition sortedKeys into
+	// allowOptimizeMaxFieldLen-sized groups and intersect each one
+	// independently. At the closing quote, resolveBitmapGroupField walks the
+	// groups in order.
+	var groups []*bitmapGroup
+	for len(sortedKeys) > 0 {
+		n := allowOptimizeMaxFieldLen
+		if n > len(sortedKeys) {
+			n = len(sortedKeys)
+		}
+		chunk := sortedKeys[:n]
+		sortedKeys = sortedKeys[n:]
+
+		var groupMaxKeyLen int
+		for _, key := range chunk {
+			if len(key) > groupMaxKeyLen {
+				groupMaxKeyLen = len(key)
 			}
-			d.sortedFieldSets = append(d.sortedFieldSets, fieldMap[key])
-		}
-		d.keyBitmapInt8 = keyBitmap
-		d.keyDecoder = decodeKeyByBitmapInt8
-		d.keyStreamDecoder = decodeKeyByBitmapInt8Stream
-	} else {
-		keyBitmap := make([][256]int16, maxKeyLen)
-		for i, key := range sortedKeys {
+		}
+
+		group := &bitmapGroup{keyBitmap: make([][256]int16, groupMaxKeyLen)}
+		for i, key := range chunk {
 			for j := 0; j < len(key); j++ {
 				c := key[j]
-				keyBitmap[j][c] |= (1 << uint(i))
+				group.keyBitmap[j][c] |= (1 << uint(i))
 			}
-			d.sortedFieldSets = append(d.sortedFieldSets, fieldMap[key])
+			group.sortedFieldSets = append(group.sortedFieldSets, fieldMap[key])
+		}
+		groups = append(groups, group)
+	}
+	d.groups = groups
+	d.keyDecoder = decodeKeyByBitmapGroups
+	d.keyStreamDecoder = decodeKeyByBitmapGroupsStream
+}
+
+// resolveBitmapGroupField picks the surviving group (if any) at the closing
+// quote and recovers its field the same way the single-table bitmap
+// decoders do: the de Bruijn hash on the lone surviving bit, then the
+// keyLen < field.keyLen early-match short-circuit.
+func resolveBitmapGroupField(groups []*bitmapGroup, curBits []int16, keyLen int64) *structFieldSet {
+	for gi, g := range groups {
+		curBit := curBits[gi]
+		if curBit == 0 {
+			continue
 		}
-		d.keyBitmapInt16 = keyBitmap
-		d.keyDecoder = decodeKeyByBitmapInt16
-		d.keyStreamDecoder = decodeKeyByBitmapInt16Stream
+		x := uint64(uint16(curBit) & uint16(-curBit))
+		fieldSetIndex := bitHashTable[(x*0x03F566ED27179461)>>58]
+		field := g.sortedFieldSets[fieldSetIndex]
+		if keyLen < field.keyLen {
+			// early match: this group's survivor is a longer field whose
+			// prefix matched, not a real hit. A key can straddle a group
+			// boundary (e.g. "ab" ending one group, "abc" starting the
+			// next), so the exact match may still be waiting in a later
+			// group — keep walking instead of giving up on the whole key.
+			continue
+		}
+		return field
 	}
+	return nil
 }
 
-func decodeKeyByBitmapInt8(d *structDecoder, buf []byte, cursor int64) (int64, *structFieldSet, error) {
+func decodeKeyByBitmapInt8(d *structDecoder, buf []byte, cursor int64) (int64, *structFieldSet, string, error) {
 	var (
 		field  *structFieldSet
 		curBit int8 = math.MaxInt8
@@ -152,9 +358,9 @@ func decodeKeyByBitmapInt8(d *structDecoder, buf []byte, cursor int64) (int64, *
 			switch c {
 			case '"':
 				cursor++
-				return cursor, field, nil
+				return cursor, field, "", nil
 			case nul:
-				return 0, nil, errUnexpectedEndOfJSON("string", cursor)
+				return 0, nil, "", errUnexpectedEndOfJSON("string", cursor)
 			}
 			keyIdx := 0
 			bitmap := d.keyBitmapInt8
@@ -168,47 +374,54 @@ func decodeKeyByBitmapInt8(d *structDecoder, buf []byte, cursor int64) (int64, *
 					fieldSetIndex := bitHashTable[(x*0x03F566ED27179461)>>58]
 					field = d.sortedFieldSets[fieldSetIndex]
 					keyLen := cursor - start
+					key := unescapeKey(buf[start:cursor])
 					cursor++
 					if keyLen < field.keyLen {
 						// early match
-						return cursor, nil, nil
+						return cursor, nil, key, nil
 					}
-					return cursor, field, nil
+					return cursor, field, key, nil
 				case nul:
-					return 0, nil, errUnexpectedEndOfJSON("string", cursor)
+					return 0, nil, "", errUnexpectedEndOfJSON("string", cursor)
 				default:
 					if keyIdx >= keyBitmapLen {
 						for {
 							cursor++
 							switch char(b, cursor) {
 							case '"':
+								key := unescapeKey(buf[start:cursor])
 								cursor++
-								return cursor, field, nil
+								return cursor, field, key, nil
 							case '\\':
 								cursor++
 								if char(b, cursor) == nul {
-									return 0, nil, errUnexpectedEndOfJSON("string", cursor)
+									return 0, nil, "", errUnexpectedEndOfJSON("string", cursor)
 								}
 							case nul:
-								return 0, nil, errUnexpectedEndOfJSON("string", cursor)
+								return 0, nil, "", errUnexpectedEndOfJSON("string", cursor)
 							}
 						}
 					}
-					curBit &= bitmap[keyIdx][largeToSmallTable[c]]
+					idx := c
+					if !d.caseSensitive {
+						idx = largeToSmallTable[c]
+					}
+					curBit &= bitmap[keyIdx][idx]
 					if curBit == 0 {
 						for {
 							cursor++
 							switch char(b, cursor) {
 							case '"':
+								key := unescapeKey(buf[start:cursor])
 								cursor++
-								return cursor, field, nil
+								return cursor, field, key, nil
 							case '\\':
 								cursor++
 								if char(b, cursor) == nul {
-									return 0, nil, errUnexpectedEndOfJSON("string", cursor)
+									return 0, nil, "", errUnexpectedEndOfJSON("string", cursor)
 								}
 							case nul:
-								return 0, nil, errUnexpectedEndOfJSON("string", cursor)
+								return 0, nil, "", errUnexpectedEndOfJSON("string", cursor)
 							}
 						}
 					}
@@ -217,15 +430,18 @@ func decodeKeyByBitmapInt8(d *structDecoder, buf []byte, cursor int64) (int64, *
 				cursor++
 			}
 		default:
-			return cursor, nil, errNotAtBeginningOfValue(cursor)
+			return cursor, nil, "", errNotAtBeginningOfValue(cursor)
 		}
 	}
 }
 
-func decodeKeyByBitmapInt16(d *structDecoder, buf []byte, cursor int64) (int64, *structFieldSet, error) {
+func decodeKeyByBitmapInt16(d *structDecoder, buf []byte, cursor int64) (int64, *structFieldSet, string, error) {
 	var (
-		field  *structFieldSet
-		curBit int16 = math.MaxInt16
+		field *structFieldSet
+		// -1 keeps all 16 bits live, including bit 15. math.MaxInt16 (0x7FFF)
+		// would leave bit 15 cleared, so the 16th sorted field could never
+		// survive the AND-narrowing below.
+		curBit int16 = -1
 	)
 	b := (*sliceHeader)(unsafe.Pointer(&buf)).data
 	for {
@@ -238,9 +454,9 @@ func decodeKeyByBitmapInt16(d *structDecoder, buf []byte, cursor int64) (int64,
 			switch c {
 			case '"':
 				cursor++
-				return cursor, field, nil
+				return cursor, field, "", nil
 			case nul:
-				return 0, nil, errUnexpectedEndOfJSON("string", cursor)
+				return 0, nil, "", errUnexpectedEndOfJSON("string", cursor)
 			}
 			keyIdx := 0
 			bitmap := d.keyBitmapInt16
@@ -250,51 +466,58 @@ func decodeKeyByBitmapInt16(d *structDecoder, buf []byte, cursor int64) (int64,
 				c := char(b, cursor)
 				switch c {
 				case '"':
-					x := uint64(curBit & -curBit)
+					x := uint64(uint16(curBit) & uint16(-curBit))
 					fieldSetIndex := bitHashTable[(x*0x03F566ED27179461)>>58]
 					field = d.sortedFieldSets[fieldSetIndex]
 					keyLen := cursor - start
+					key := unescapeKey(buf[start:cursor])
 					cursor++
 					if keyLen < field.keyLen {
 						// early match
-						return cursor, nil, nil
+						return cursor, nil, key, nil
 					}
-					return cursor, field, nil
+					return cursor, field, key, nil
 				case nul:
-					return 0, nil, errUnexpectedEndOfJSON("string", cursor)
+					return 0, nil, "", errUnexpectedEndOfJSON("string", cursor)
 				default:
 					if keyIdx >= keyBitmapLen {
 						for {
 							cursor++
 							switch char(b, cursor) {
 							case '"':
+								key := unescapeKey(buf[start:cursor])
 								cursor++
-								return cursor, field, nil
+								return cursor, field, key, nil
 							case '\\':
 								cursor++
 								if char(b, cursor) == nul {
-									return 0, nil, errUnexpectedEndOfJSON("string", cursor)
+									return 0, nil, "", errUnexpectedEndOfJSON("string", cursor)
 								}
 							case nul:
-								return 0, nil, errUnexpectedEndOfJSON("string", cursor)
+								return 0, nil, "", errUnexpectedEndOfJSON("string", cursor)
 							}
 						}
 					}
-					curBit &= bitmap[keyIdx][largeToSmallTable[c]]
+					idx := c
+					if !d.caseSensitive {
+						idx = largeToSmallTable[c]
+					}
+					curBit &= bitmap[keyIdx][idx]
 					if curBit == 0 {
 						for {
 							cursor++
 							switch char(b, cursor) {
 							case '"':
+								key := unescapeKey(buf[start:cursor])
 								cursor++
-								return cursor, field, nil
+								return cursor, field, key, nil
 							case '\\':
 								cursor++
 								if char(b, cursor) == nul {
-									return 0, nil, errUnexpectedEndOfJSON("string", cursor)
+									return 0, nil, "", errUnexpectedEndOfJSON("string", cursor)
 								}
 							case nul:
-								return 0, nil, errUnexpectedEndOfJSON("string", cursor)
+								return 0, nil, "", errUnexpectedEndOfJSON("string", cursor)
 							}
 						}
 					}
@@ -303,23 +526,111 @@ func decodeKeyByBitmapInt16(d *structDecoder, buf []byte, cursor int64) (int64,
 				cursor++
 			}
 		default:
-			return cursor, nil, errNotAtBeginningOfValue(cursor)
+			return cursor, nil, "", errNotAtBeginningOfValue(cursor)
 		}
 	}
 }
 
-func decodeKey(d *structDecoder, buf []byte, cursor int64) (int64, *structFieldSet, error) {
+// decodeKeyByBitmapGroups is the wide-struct counterpart of
+// decodeKeyByBitmapInt8/Int16: it runs the same per-position bitmap
+// intersection, but against every group in d.groups in lockstep, so structs
+// with more than allowOptimizeMaxFieldLen fields still get the bitmap fast
+// path instead of falling all the way back to decodeKey's map lookup.
+func decodeKeyByBitmapGroups(d *structDecoder, buf []byte, cursor int64) (int64, *structFieldSet, string, error) {
+	b := (*sliceHeader)(unsafe.Pointer(&buf)).data
+	for {
+		switch char(b, cursor) {
+		case ' ', '\n', '\t', '\r':
+			cursor++
+		case '"':
+			cursor++
+			c := char(b, cursor)
+			switch c {
+			case '"':
+				cursor++
+				return cursor, nil, "", nil
+			case nul:
+				return 0, nil, "", errUnexpectedEndOfJSON("string", cursor)
+			}
+			groups := d.groups
+			curBits := make([]int16, len(groups))
+			for i := range curBits {
+				// -1 keeps all 16 bits live; see decodeKeyByBitmapInt16.
+				curBits[i] = -1
+			}
+			alive := len(groups)
+			keyIdx := 0
+			start := cursor
+			for {
+				c := char(b, cursor)
+				switch c {
+				case '"':
+					keyLen := cursor - start
+					field := resolveBitmapGroupField(groups, curBits, keyLen)
+					key := unescapeKey(buf[start:cursor])
+					cursor++
+					return cursor, field, key, nil
+				case nul:
+					return 0, nil, "", errUnexpectedEndOfJSON("string", cursor)
+				default:
+					if alive == 0 {
+						for {
+							cursor++
+							switch char(b, cursor) {
+							case '"':
+								key := unescapeKey(buf[start:cursor])
+								cursor++
+								return cursor, nil, key, nil
+							case '\\':
+								cursor++
+								if char(b, cursor) == nul {
+									return 0, nil, "", errUnexpectedEndOfJSON("string", cursor)
+								}
+							case nul:
+								return 0, nil, "", errUnexpectedEndOfJSON("string", cursor)
+							}
+						}
+					}
+					idx := c
+					if !d.caseSensitive {
+						idx = largeToSmallTable[c]
+					}
+					for gi, g := range groups {
+						if curBits[gi] == 0 {
+							continue
+						}
+						if keyIdx >= len(g.keyBitmap) {
+							curBits[gi] = 0
+							alive--
+							continue
+						}
+						curBits[gi] &= g.keyBitmap[keyIdx][idx]
+						if curBits[gi] == 0 {
+							alive--
+						}
+					}
+					keyIdx++
+				}
+				cursor++
+			}
+		default:
+			return cursor, nil, "", errNotAtBeginningOfValue(cursor)
+		}
+	}
+}
+
+func decodeKey(d *structDecoder, buf []byte, cursor int64) (int64, *structFieldSet, string, error) {
 	key, c, err := d.stringDecoder.decodeByte(buf, cursor)
 	if err != nil {
-		return 0, nil, err
+		return 0, nil, "", err
 	}
 	cursor = c
 	k := *(*string)(unsafe.Pointer(&key))
 	field, exists := d.fieldMap[k]
 	if !exists {
-		return cursor, nil, nil
+		return cursor, nil, k, nil
 	}
-	return cursor, field, nil
+	return cursor, field, k, nil
 }
 
 func decodeKeyByBitmapInt8Stream(d *structDecoder, s *stream) (*structFieldSet, string, error) {
@@ -378,8 +689,7 @@ func decodeKeyByBitmapInt8Stream(d *structDecoder, s *stream) (*structFieldSet,
 							s.cursor++
 							switch s.char() {
 							case '"':
-								b := s.buf[start:s.cursor]
-								key := *(*string)(unsafe.Pointer(&b))
+								key := unescapeKey(s.buf[start:s.cursor])
 								s.cursor++
 								return field, key, nil
 							case '\\':
@@ -396,14 +706,17 @@ func decodeKeyByBitmapInt8Stream(d *structDecoder, s *stream) (*structFieldSet,
 							}
 						}
 					}
-					curBit &= bitmap[keyIdx][largeToSmallTable[c]]
+					idx := c
+					if !d.caseSensitive {
+						idx = largeToSmallTable[c]
+					}
+					curBit &= bitmap[keyIdx][idx]
 					if curBit == 0 {
 						for {
 							s.cursor++
 							switch s.char() {
 							case '"':
-								b := s.buf[start:s.cursor]
-								key := *(*string)(unsafe.Pointer(&b))
+								key := unescapeKey(s.buf[start:s.cursor])
 								s.cursor++
 								return field, key, nil
 							case '\\':
@@ -432,8 +745,9 @@ func decodeKeyByBitmapInt8Stream(d *structDecoder, s *stream) (*structFieldSet,
 
 func decodeKeyByBitmapInt16Stream(d *structDecoder, s *stream) (*structFieldSet, string, error) {
 	var (
-		field  *structFieldSet
-		curBit int16 = math.MaxInt16
+		field *structFieldSet
+		// see decodeKeyByBitmapInt16: -1 keeps bit 15 live too.
+		curBit int16 = -1
 	)
 	for {
 		switch s.char() {
@@ -465,7 +779,7 @@ func decodeKeyByBitmapInt16Stream(d *structDecoder, s *stream) (*structFieldSet,
 				c := s.char()
 				switch c {
 				case '"':
-					x := uint64(curBit & -curBit)
+					x := uint64(uint16(curBit) & uint16(-curBit))
 					fieldSetIndex := bitHashTable[(x*0x03F566ED27179461)>>58]
 					field = d.sortedFieldSets[fieldSetIndex]
 					keyLen := s.cursor - start
@@ -486,8 +800,7 @@ func decodeKeyByBitmapInt16Stream(d *structDecoder, s *stream) (*structFieldSet,
 							s.cursor++
 							switch s.char() {
 							case '"':
-								b := s.buf[start:s.cursor]
-								key := *(*string)(unsafe.Pointer(&b))
+								key := unescapeKey(s.buf[start:s.cursor])
 								s.cursor++
 								return field, key, nil
 							case '\\':
@@ -504,14 +817,17 @@ func decodeKeyByBitmapInt16Stream(d *structDecoder, s *stream) (*structFieldSet,
 							}
 						}
 					}
-					curBit &= bitmap[keyIdx][largeToSmallTable[c]]
+					idx := c
+					if !d.caseSensitive {
+						idx = largeToSmallTable[c]
+					}
+					curBit &= bitmap[keyIdx][idx]
 					if curBit == 0 {
 						for {
 							s.cursor++
 							switch s.char() {
 							case '"':
-								b := s.buf[start:s.cursor]
-								key := *(*string)(unsafe.Pointer(&b))
+								key := unescapeKey(s.buf[start:s.cursor])
 								s.cursor++
 								return field, key, nil
 							case '\\':
@@ -538,6 +854,106 @@ func decodeKeyByBitmapInt16Stream(d *structDecoder, s *stream) (*structFieldSet,
 	}
 }
 
+// decodeKeyByBitmapGroupsStream is decodeKeyByBitmapGroups for the
+// incremental *stream reader, mirroring how decodeKeyByBitmapInt16Stream
+// relates to decodeKeyByBitmapInt16.
+func decodeKeyByBitmapGroupsStream(d *structDecoder, s *stream) (*structFieldSet, string, error) {
+	for {
+		switch s.char() {
+		case ' ', '\n', '\t', '\r':
+			s.cursor++
+		case nul:
+			if s.read() {
+				continue
+			}
+			return nil, "", errNotAtBeginningOfValue(s.totalOffset())
+		case '"':
+			s.cursor++
+		FIRST_CHAR:
+			start := s.cursor
+			switch s.char() {
+			case '"':
+				s.cursor++
+				return nil, "", nil
+			case nul:
+				if s.read() {
+					goto FIRST_CHAR
+				}
+				return nil, "", errUnexpectedEndOfJSON("string", s.totalOffset())
+			}
+			groups := d.groups
+			curBits := make([]int16, len(groups))
+			for i := range curBits {
+				// -1 keeps all 16 bits live; see decodeKeyByBitmapInt16.
+				curBits[i] = -1
+			}
+			alive := len(groups)
+			keyIdx := 0
+			for {
+				c := s.char()
+				switch c {
+				case '"':
+					keyLen := s.cursor - start
+					field := resolveBitmapGroupField(groups, curBits, keyLen)
+					key := unescapeKey(s.buf[start:s.cursor])
+					s.cursor++
+					return field, key, nil
+				case nul:
+					if s.read() {
+						continue
+					}
+					return nil, "", errUnexpectedEndOfJSON("string", s.totalOffset())
+				default:
+					if alive == 0 {
+						for {
+							s.cursor++
+							switch s.char() {
+							case '"':
+								key := unescapeKey(s.buf[start:s.cursor])
+								s.cursor++
+								return nil, key, nil
+							case '\\':
+								s.cursor++
+								if s.char() == nul {
+									if !s.read() {
+										return nil, "", errUnexpectedEndOfJSON("string", s.totalOffset())
+									}
+								}
+							case nul:
+								if !s.read() {
+									return nil, "", errUnexpectedEndOfJSON("string", s.totalOffset())
+								}
+							}
+						}
+					}
+					idx := c
+					if !d.caseSensitive {
+						idx = largeToSmallTable[c]
+					}
+					for gi, g := range groups {
+						if curBits[gi] == 0 {
+							continue
+						}
+						if keyIdx >= len(g.keyBitmap) {
+							curBits[gi] = 0
+							alive--
+							continue
+						}
+						curBits[gi] &= g.keyBitmap[keyIdx][idx]
+						if curBits[gi] == 0 {
+							alive--
+						}
+					}
+					keyIdx++
+				}
+				s.cursor++
+			}
+		default:
+			return nil, "", errNotAtBeginningOfValue(s.totalOffset())
+		}
+	}
+}
+
 func decodeKeyStream(d *structDecoder, s *stream) (*structFieldSet, string, error) {
 	key, err := d.stringDecoder.decodeStreamByte(s)
 	if err != nil {
@@ -587,6 +1003,12 @@ func (d *structDecoder) decodeStream(s *stream, p unsafe.Pointer) error {
 			if err := field.dec.decodeStream(s, unsafe.Pointer(uintptr(p)+field.offset)); err != nil {
 				return err
 			}
+		} else if d.hasCatchAll {
+			start := s.cursor
+			if err := s.skipValue(); err != nil {
+				return err
+			}
+			assignCatchAllField(p, d.catchAllOffset, key, s.buf[start:s.cursor])
 		} else if s.disallowUnknownFields {
 			return fmt.Errorf("json: unknown field %q", key)
 		} else {
@@ -636,7 +1058,7 @@ func (d *structDecoder) decode(buf []byte, cursor int64, p unsafe.Pointer) (int6
 	}
 	cursor++
 	for {
-		c, field, err := d.keyDecoder(d, buf, cursor)
+		c, field, key, err := d.keyDecoder(d, buf, cursor)
 		if err != nil {
 			return 0, err
 		}
@@ -654,6 +1076,14 @@ func (d *structDecoder) decode(buf []byte, cursor int64, p unsafe.Pointer) (int6
 				return 0, err
 			}
 			cursor = c
+		} else if d.hasCatchAll {
+			start := cursor
+			c, err := skipValue(buf, cursor)
+			if err != nil {
+				return 0, err
+			}
+			assignCatchAllField(p, d.catchAllOffset, key, buf[start:c])
+			cursor = c
 		} else {
 			c, err := skipValue(buf, cursor)
 			if err != nil {
@@ -0,0 +1,216 @@
+package json
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"unsafe"
+)
+
+// TestCaseSensitiveDecodeRejectsWrongCase covers strict mode: with
+// caseSensitive set, a key that only matches up to case must not decode
+// into the field it differs from.
+func TestCaseSensitiveDecodeRejectsWrongCase(t *testing.T) {
+	vals := []int{-1}
+	fieldMap := map[string]*structFieldSet{
+		"Name": {dec: &recordingDecoder{id: 0}, offset: 0, key: "Name", keyLen: 4},
+	}
+
+	d := newStructDecoder("S", "f", true, fieldMap, 0, false)
+	d.tryOptimize()
+
+	buf := []byte(`{"name":1}`)
+	if _, err := d.decode(buf, 0, unsafe.Pointer(&vals[0])); err != nil {
+		t.Fatalf("decode failed: %v", err)
+	}
+	if vals[0] != -1 {
+		t.Errorf("expected case-sensitive mode to leave %q unmatched against %q, got field %d", "name", "Name", vals[0])
+	}
+}
+
+// TestTryOptimizeAllowsCaseFoldedAliases covers two alias keys (distinct
+// *structFieldSet values, same dec/offset) that case-fold to the same
+// bitmap key: tryOptimize must still pick the bitmap fast path instead of
+// bailing out as if they were two unrelated fields fighting over one slot.
+func TestTryOptimizeAllowsCaseFoldedAliases(t *testing.T) {
+	vals := []int{-1}
+	shared := &recordingDecoder{id: 0}
+	fieldMap := map[string]*structFieldSet{
+		"name": {dec: shared, offset: 0, key: "name", keyLen: 4},
+		"Name": {dec: shared, offset: 0, key: "Name", keyLen: 4},
+	}
+
+	d := newStructDecoder("S", "f", false, fieldMap, 0, false)
+	d.tryOptimize()
+	if d.isTriedOptimize {
+		t.Fatal("expected aliases sharing dec/offset to still use the bitmap fast path")
+	}
+
+	buf := []byte(`{"Name":1}`)
+	if _, err := d.decode(buf, 0, unsafe.Pointer(&vals[0])); err != nil {
+		t.Fatalf("decode failed: %v", err)
+	}
+	if vals[0] != 0 {
+		t.Errorf("expected alias key to decode into the shared field, got %d", vals[0])
+	}
+}
+
+// TestTryOptimizeSizesGroupBitmapToItsOwnKeys covers a wide struct where
+// one group's keys are much shorter than another's: each bitmapGroup
+// should size its keyBitmap off its own chunk's longest key, not the
+// struct-wide maximum, or a struct with one long key and many short ones
+// would allocate every group at the long key's height for nothing.
+func TestTryOptimizeSizesGroupBitmapToItsOwnKeys(t *testing.T) {
+	keys := make([]string, 0, 17)
+	for i := 0; i < 16; i++ {
+		keys = append(keys, fmt.Sprintf("k%02d", i))
+	}
+	keys = append(keys, strings.Repeat("z", 20))
+	vals := make([]int, len(keys))
+	fieldMap := newRecordingFieldMap(keys, vals)
+
+	d := newStructDecoder("S", "f", false, fieldMap, 0, false)
+	d.tryOptimize()
+	if len(d.groups) != 2 {
+		t.Fatalf("expected 2 groups, got %d", len(d.groups))
+	}
+	if got, want := len(d.groups[0].keyBitmap), 3; got != want {
+		t.Errorf("group 0 (short keys) keyBitmap height = %d, want %d (its own longest key, not the struct-wide max)", got, want)
+	}
+	if got, want := len(d.groups[1].keyBitmap), 20; got != want {
+		t.Errorf("group 1 (long key) keyBitmap height = %d, want %d", got, want)
+	}
+}
+
+// TestAssignCatchAllFieldClonesKey covers a buffer-reuse scenario: the key
+// handed to assignCatchAllField is a zero-copy view into the caller's
+// buffer (see bufToString), which may be overwritten after the call
+// returns (a pooled buffer, or a streaming decoder refilling s.buf). The
+// stored key must survive that.
+func TestAssignCatchAllFieldClonesKey(t *testing.T) {
+	buf := []byte(`extra`)
+	raw := []byte(`1`)
+	var m map[string]RawMessage
+
+	assignCatchAllField(unsafe.Pointer(&m), 0, bufToString(buf), raw)
+
+	copy(buf, []byte(`XXXXX`))
+
+	if _, ok := m["extra"]; !ok {
+		t.Errorf("expected catch-all map to have key %q, got %v", "extra", m)
+	}
+}
+
+// TestDecodeBitmapFastPathUnescapesCatchAllKey covers an unknown key that
+// reaches assignCatchAllField via the bitmap fast path: decodeKey's slow path
+// unescapes keys through stringDecoder, but decodeKeyByBitmapInt8/Int16/Groups
+// only watch for '\\' to avoid mistaking "\"" for the closing quote, so the
+// captured key must be explicitly unescaped before it's used as a map key or
+// the catch-all map ends up keyed by the raw JSON text instead of the string
+// it represents.
+func TestDecodeBitmapFastPathUnescapesCatchAllKey(t *testing.T) {
+	type target struct {
+		Extra map[string]RawMessage
+	}
+
+	fieldMap := map[string]*structFieldSet{
+		"name": {dec: &recordingDecoder{id: 0}, offset: 0, key: "name", keyLen: 4},
+	}
+	d := newStructDecoder("S", "f", false, fieldMap, unsafe.Offsetof(target{}.Extra), true)
+	d.tryOptimize()
+	if d.isTriedOptimize {
+		t.Fatal("expected this small struct to use the bitmap fast path")
+	}
+
+	var tgt target
+	buf := []byte(`{"name":1,"a\\b":2,"caf\u00e9":3}`)
+	if _, err := d.decode(buf, 0, unsafe.Pointer(&tgt)); err != nil {
+		t.Fatalf("decode failed: %v", err)
+	}
+
+	if _, ok := tgt.Extra[`a\b`]; !ok {
+		t.Errorf(`expected catch-all map to have unescaped key %q, got %v`, `a\b`, tgt.Extra)
+	}
+	if _, ok := tgt.Extra["café"]; !ok {
+		t.Errorf("expected catch-all map to have \\u-decoded key %q, got %v", "café", tgt.Extra)
+	}
+}
+
+// recordingDecoder is a minimal decoder stub used by the structDecoder tests
+// below: it skips over whatever value it's pointed at and records its own id
+// into the destination int, so a test can tell which field actually decoded.
+type recordingDecoder struct {
+	id int
+}
+
+func (d *recordingDecoder) decode(buf []byte, cursor int64, p unsafe.Pointer) (int64, error) {
+	c, err := skipValue(buf, cursor)
+	if err != nil {
+		return 0, err
+	}
+	*(*int)(p) = d.id
+	return c, nil
+}
+
+func (d *recordingDecoder) decodeStream(s *stream, p unsafe.Pointer) error {
+	if err := s.skipValue(); err != nil {
+		return err
+	}
+	*(*int)(p) = d.id
+	return nil
+}
+
+// newRecordingFieldMap builds a fieldMap of len(keys) fields backed by vals,
+// one *structFieldSet per key, each writing its own index into vals[i] when
+// decoded.
+func newRecordingFieldMap(keys []string, vals []int) map[string]*structFieldSet {
+	fieldMap := make(map[string]*structFieldSet, len(keys))
+	for i, key := range keys {
+		fieldMap[key] = &structFieldSet{
+			dec:    &recordingDecoder{id: i},
+			offset: uintptr(i) * unsafe.Sizeof(vals[0]),
+			key:    key,
+			keyLen: int64(len(key)),
+		}
+	}
+	return fieldMap
+}
+
+// TestGroupBoundaryExactMatch covers a struct wide enough (>16 fields) to
+// require tryOptimize's grouped bitmap fallback, where a short key ends one
+// group and a longer key sharing its prefix starts the next. Before the
+// fix, resolveBitmapGroupField gave up on the very first group whose
+// survivor failed the keyLen < field.keyLen check, even though a later
+// group held the real exact match.
+func TestGroupBoundaryExactMatch(t *testing.T) {
+	keys := []string{
+		"a0", "a1", "a2", "a3", "a4", "a5", "a6", "a7", "a8", "a9",
+		"aa0", "aa1", "aa2", "aa3", "aa4",
+		"ab", "abc",
+	}
+	if len(keys) <= allowOptimizeMaxFieldLen {
+		t.Fatalf("test setup needs more than %d fields", allowOptimizeMaxFieldLen)
+	}
+	vals := make([]int, len(keys))
+	fieldMap := newRecordingFieldMap(keys, vals)
+
+	d := newStructDecoder("S", "f", false, fieldMap, 0, false)
+	d.tryOptimize()
+	if d.groups == nil {
+		t.Fatal("expected tryOptimize to pick the grouped bitmap path for a wide struct")
+	}
+
+	buf := []byte(`{"ab":1,"abc":2}`)
+	if _, err := d.decode(buf, 0, unsafe.Pointer(&vals[0])); err != nil {
+		t.Fatalf("decode failed: %v", err)
+	}
+
+	abID := fieldMap["ab"].dec.(*recordingDecoder).id
+	abcID := fieldMap["abc"].dec.(*recordingDecoder).id
+	if vals[abID] != abID {
+		t.Errorf("key %q: expected field %d to be matched, was left at %d", "ab", abID, vals[abID])
+	}
+	if vals[abcID] != abcID {
+		t.Errorf("key %q: expected field %d to be matched, was left at %d", "abc", abcID, vals[abcID])
+	}
+}